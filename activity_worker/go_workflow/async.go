@@ -0,0 +1,39 @@
+package main
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+var (
+	// SayHelloAsync demonstrates async activity completion.
+	SayHelloAsync = Workflow[string, string]{
+		Name:      "say-hello-async-workflow",
+		TaskQueue: taskQueue,
+		Fn:        SayHelloAsyncWorkflow,
+		Block:     "sayhello",
+		Element:   "greet-async",
+	}
+	// SayHelloAsyncActivity returns activity.ErrResultPending as soon as
+	// it's picked up; its result arrives later via the /complete HTTP
+	// endpoint in main.go forwarding to client.CompleteActivity.
+	SayHelloAsyncActivity = Activity[string, string]{
+		Name:      "say-hello-async-activity",
+		TaskQueue: taskQueue,
+	}
+)
+
+// SayHelloAsyncWorkflow is SayHelloWorkflow's async counterpart: it calls
+// say-hello-async-activity and waits however long it takes an external
+// caller to POST the result to /complete, rather than for the activity
+// function itself to return.
+func SayHelloAsyncWorkflow(ctx workflow.Context, name string) (string, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// No ScheduleToCloseTimeout: completion is bounded by how long
+		// the external caller takes, not by the activity function.
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    10 * time.Second,
+	})
+	return SayHelloAsyncActivity.ExecuteActivity(ctx, name)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/base64"
+	"log"
+	"net/http"
+
+	"go.temporal.io/sdk/client"
+)
+
+// newCompletionServer bridges external callbacks into Temporal: POST
+// /complete?token=<base64 task token>&greeting=<result> decodes the task
+// token and forwards greeting to CompleteActivity, resolving whichever
+// async activity invocation (e.g. say-hello-async-activity) is holding it.
+func newCompletionServer(addr string, c client.Client) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/complete", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "POST only", http.StatusMethodNotAllowed)
+			return
+		}
+		token, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("token"))
+		if err != nil {
+			http.Error(w, "invalid token: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		greeting := r.URL.Query().Get("greeting")
+		if err := c.CompleteActivity(r.Context(), token, greeting, nil); err != nil {
+			log.Printf("Failed completing activity: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return &http.Server{Addr: addr, Handler: mux}
+}
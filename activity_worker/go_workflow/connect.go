@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.temporal.io/sdk/client"
+)
+
+// connectOptions configures newClientWithRetry's backoff between dial
+// attempts.
+type connectOptions struct {
+	InitialInterval time.Duration
+	Coefficient     float64
+	MaxInterval     time.Duration
+	MaxAttempts     int
+}
+
+var defaultConnectOptions = connectOptions{
+	InitialInterval: time.Second,
+	Coefficient:     2.0,
+	MaxInterval:     time.Minute,
+	MaxAttempts:     5,
+}
+
+// newClientWithRetry dials the Temporal frontend, retrying with
+// exponential backoff per retry, and confirms it's healthy via
+// CheckHealth before returning -- so a worker built on top of this never
+// starts polling a frontend that accepted the connection but isn't ready
+// to serve.
+func newClientWithRetry(ctx context.Context, opts client.Options, retry connectOptions) (client.Client, error) {
+	interval := retry.InitialInterval
+	var lastErr error
+	for attempt := 1; attempt <= retry.MaxAttempts; attempt++ {
+		c, err := client.NewClient(opts)
+		if err != nil {
+			lastErr = fmt.Errorf("dial failed: %w", err)
+		} else if _, err := c.CheckHealth(ctx, &client.CheckHealthRequest{}); err != nil {
+			c.Close()
+			lastErr = fmt.Errorf("health check failed: %w", err)
+		} else {
+			return c, nil
+		}
+
+		log.Printf("Failed connecting to Temporal frontend (attempt %d/%d): %v", attempt, retry.MaxAttempts, lastErr)
+		if attempt == retry.MaxAttempts {
+			break
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if interval = time.Duration(float64(interval) * retry.Coefficient); interval > retry.MaxInterval {
+			interval = retry.MaxInterval
+		}
+	}
+	return nil, fmt.Errorf("giving up connecting to Temporal frontend after %d attempts: %w", retry.MaxAttempts, lastErr)
+}
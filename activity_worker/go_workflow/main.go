@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net/http"
+	"os"
 	"time"
 
 	"go.temporal.io/sdk/client"
@@ -11,9 +14,27 @@ import (
 )
 
 const (
-	taskQueue    = "say-hello-task-queue"
-	workflowName = "say-hello-workflow"
-	activityName = "say-hello-activity"
+	taskQueue      = "say-hello-task-queue"
+	completionAddr = ":8088"
+)
+
+var (
+	// SayHello is the workflow this worker registers and runs. Block and
+	// Element give Queue a "sayhello.greet.{name}" ID scheme.
+	SayHello = Workflow[string, string]{
+		Name:      "say-hello-workflow",
+		TaskQueue: taskQueue,
+		Fn:        SayHelloWorkflow,
+		Block:     "sayhello",
+		Element:   "greet",
+	}
+	// SayHelloActivity is handled by the separately-hosted
+	// say-hello-activity worker; declaring it here keeps its name and
+	// types in lockstep with what SayHelloWorkflow calls.
+	SayHelloActivity = Activity[string, string]{
+		Name:      "say-hello-activity",
+		TaskQueue: taskQueue,
+	}
 )
 
 // SayHelloWorkflow simply returns the result of the say-hello activity.
@@ -23,24 +44,64 @@ func SayHelloWorkflow(ctx workflow.Context, name string) (string, error) {
 		ScheduleToCloseTimeout: 5 * time.Second,
 		RetryPolicy:            &temporal.RetryPolicy{MaximumAttempts: 1},
 	})
-	var response string
-	err := workflow.ExecuteActivity(ctx, activityName, name).Get(ctx, &response)
-	return response, err
+	return SayHelloActivity.ExecuteActivity(ctx, name)
 }
 
 func main() {
-	// Create client to localhost on default namespace
-	c, err := client.NewClient(client.Options{})
+	// Create client to localhost on default namespace, retrying with
+	// backoff and confirming frontend health before use.
+	c, err := newClientWithRetry(context.Background(), client.Options{}, defaultConnectOptions)
 	if err != nil {
 		log.Fatalf("Failed creating client: %v", err)
 	}
 	defer c.Close()
 
+	queue := &Queue{Name: taskQueue, Client: c}
+
+	// `go run . start [name]` executes SayHello instead of running the
+	// worker, e.g. to smoke-test against a worker started separately.
+	if len(os.Args) > 1 && os.Args[1] == "start" {
+		runStarter(queue)
+		return
+	}
+
 	// Run workflow-only worker that does not handle activities
 	w := worker.New(c, taskQueue, worker.Options{LocalActivityWorkerOnly: true})
-	w.RegisterWorkflowWithOptions(SayHelloWorkflow, workflow.RegisterOptions{Name: workflowName})
+
+	reg := NewRegistry(taskQueue)
+	RegisterWorkflow(reg, w, SayHello)
+	DeclareActivity(reg, SayHelloActivity)
+	RegisterWorkflow(reg, w, SayHelloAsync)
+	DeclareActivity(reg, SayHelloAsyncActivity)
+
+	// Bridges say-hello-async-activity's async completions into Temporal;
+	// see completion_server.go.
+	completionServer := newCompletionServer(completionAddr, c)
+	go func() {
+		log.Printf("Listening for activity completions on %s", completionAddr)
+		if err := completionServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Completion server failed: %v", err)
+		}
+	}()
+
 	log.Printf("Starting worker (ctrl+c to exit)")
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalf("Worker failed to start: %v", err)
 	}
 }
+
+// runStarter executes SayHello for name (default "World") and prints its
+// result, producing a stable workflow ID of "sayhello.greet.{name}" so
+// repeat runs for the same name reuse or reject the prior execution per
+// queue.Collision.
+func runStarter(queue *Queue) {
+	name := "World"
+	if len(os.Args) > 2 {
+		name = os.Args[2]
+	}
+	resp, err := ExecuteWorkflow(context.Background(), queue, SayHello, name)
+	if err != nil {
+		log.Fatalf("Failed executing workflow: %v", err)
+	}
+	log.Printf("Result: %s", resp)
+}
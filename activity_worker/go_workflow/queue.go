@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Queue owns a task queue name and the client used to start work on it.
+// Its ExecuteWorkflow/ExecuteChildWorkflow helpers derive a Block-Element-
+// Modifier workflow ID from the workflow being started and its request, so
+// callers get stable, human-readable, idempotent IDs without formatting
+// them by hand.
+type Queue struct {
+	Name   string
+	Client client.Client
+
+	// Separator joins a workflow's Block, Element and request-derived
+	// Modifier into its ID. Defaults to "." when empty.
+	Separator string
+
+	// Collision controls what happens when a generated workflow ID
+	// collides with a still-running or previously-completed execution.
+	// The zero value is the server default (reuse running or previously
+	// completed IDs); set enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	// to make a given Block/Element/Modifier startable only once.
+	Collision enumspb.WorkflowIdReusePolicy
+}
+
+// workflowID builds the BEM-style ID from block, element and req, e.g.
+// "sayhello.greet.World".
+func (q *Queue) workflowID(block, element string, req any) string {
+	sep := q.Separator
+	if sep == "" {
+		sep = "."
+	}
+	return strings.Join([]string{block, element, fmt.Sprint(req)}, sep)
+}
+
+// ExecuteWorkflow starts wf on the queue with a deterministic workflow ID
+// and blocks for its result.
+func ExecuteWorkflow[Req, Resp any](ctx context.Context, q *Queue, wf Workflow[Req, Resp], req Req) (Resp, error) {
+	var resp Resp
+	opts := client.StartWorkflowOptions{
+		ID:                    q.workflowID(wf.Block, wf.Element, req),
+		TaskQueue:             q.Name,
+		WorkflowIDReusePolicy: q.Collision,
+	}
+	run, err := q.Client.ExecuteWorkflow(ctx, opts, wf.Name, req)
+	if err != nil {
+		return resp, err
+	}
+	err = run.Get(ctx, &resp)
+	return resp, err
+}
+
+// ExecuteChildWorkflow is ExecuteWorkflow's workflow-context counterpart,
+// for starting wf as a child of the workflow currently executing.
+func ExecuteChildWorkflow[Req, Resp any](ctx workflow.Context, q *Queue, wf Workflow[Req, Resp], req Req) (Resp, error) {
+	var resp Resp
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID:            q.workflowID(wf.Block, wf.Element, req),
+		TaskQueue:             q.Name,
+		WorkflowIDReusePolicy: q.Collision,
+	})
+	err := workflow.ExecuteChildWorkflow(ctx, wf.Name, req).Get(ctx, &resp)
+	return resp, err
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Workflow binds a workflow's name, task queue and Go function to its
+// request/response types. Declaring SayHelloWorkflow as a Workflow[string,
+// string] instead of a bare workflowName constant means the signature lives
+// in one place that both the starter (ExecuteWorkflow) and the
+// worker (via Registry) agree on.
+type Workflow[Req, Resp any] struct {
+	Name      string
+	TaskQueue string
+	Fn        func(ctx workflow.Context, req Req) (Resp, error)
+
+	// Block and Element are the BEM prefix Queue uses to build a
+	// deterministic workflow ID, e.g. Block "sayhello", Element "greet"
+	// and a request-derived modifier produce "sayhello.greet.World".
+	Block   string
+	Element string
+}
+
+// Activity binds an activity's name, task queue and request/response types.
+// Unlike Workflow it carries no Go function: say-hello-activity runs in a
+// separate, activity-only worker process, so this side only needs to agree
+// on the name and the types crossing the wire.
+type Activity[Req, Resp any] struct {
+	Name      string
+	TaskQueue string
+}
+
+// ExecuteActivity schedules the activity from inside a workflow and returns
+// its typed result.
+func (a Activity[Req, Resp]) ExecuteActivity(ctx workflow.Context, req Req) (Resp, error) {
+	var resp Resp
+	err := workflow.ExecuteActivity(ctx, a.Name, req).Get(ctx, &resp)
+	return resp, err
+}
+
+// Registry tracks the workflow and activity names declared for a single
+// task queue and panics the moment a name collides with one already
+// declared, or targets a different task queue than the Registry was
+// created for -- both are programmer errors in how the sample is wired,
+// caught at worker startup instead of surfacing later as a confusing
+// unrecognized-handler error at runtime. Registry only verifies collisions
+// among names declared through it; it has no way to confirm that the
+// separately-hosted say-hello-activity worker actually implements the
+// activities DeclareActivity records.
+type Registry struct {
+	taskQueue string
+	declared  map[string]bool
+}
+
+// NewRegistry creates a Registry for the given task queue.
+func NewRegistry(taskQueue string) *Registry {
+	return &Registry{taskQueue: taskQueue, declared: map[string]bool{}}
+}
+
+// RegisterWorkflow registers wf's function on w under wf.Name.
+func RegisterWorkflow[Req, Resp any](r *Registry, w worker.Worker, wf Workflow[Req, Resp]) {
+	r.declare(wf.Name, wf.TaskQueue)
+	w.RegisterWorkflowWithOptions(wf.Fn, workflow.RegisterOptions{Name: wf.Name})
+}
+
+// DeclareActivity records a's name as expected on the registry's task queue
+// without registering anything locally, since the activity itself is
+// handled by the separately-hosted say-hello-activity worker. It still
+// participates in Registry's name/queue collision checks.
+func DeclareActivity[Req, Resp any](r *Registry, a Activity[Req, Resp]) {
+	r.declare(a.Name, a.TaskQueue)
+}
+
+// declare panics if name was already declared on this Registry, or if
+// taskQueue doesn't match the queue the Registry was created for.
+func (r *Registry) declare(name, taskQueue string) {
+	if taskQueue != r.taskQueue {
+		panic(fmt.Sprintf("registry %q: %q declared for queue %q", r.taskQueue, name, taskQueue))
+	}
+	if r.declared[name] {
+		panic(fmt.Sprintf("registry %q: %q declared more than once", r.taskQueue, name))
+	}
+	r.declared[name] = true
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"testing"
+
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/client"
+	sdklog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const historyFile = "testdata/sayhello_history.json"
+
+var recordHistory = flag.Bool("record", false, "record a fresh SayHelloWorkflow history into "+historyFile+" instead of replaying it; requires a running Temporal server and TEMPORAL_WORKFLOW_ID set to a completed execution")
+
+// TestMain lets -record be parsed alongside the standard go test flags.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	os.Exit(m.Run())
+}
+
+// TestRecordHistory fetches a completed SayHelloWorkflow execution's history
+// and saves it to testdata/sayhello_history.json. It only runs with
+// -record, since it needs a real Temporal server; normal `go test` runs
+// replay against the checked-in fixture instead.
+func TestRecordHistory(t *testing.T) {
+	if !*recordHistory {
+		t.Skip("run with -record to regenerate " + historyFile)
+	}
+	workflowID := os.Getenv("TEMPORAL_WORKFLOW_ID")
+	if workflowID == "" {
+		t.Fatal("TEMPORAL_WORKFLOW_ID must name a completed SayHelloWorkflow execution")
+	}
+
+	c, err := client.NewClient(client.Options{})
+	if err != nil {
+		t.Fatalf("Failed creating client: %v", err)
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	var events []*history.HistoryEvent
+	iter := c.GetWorkflowHistory(ctx, workflowID, "", false, 0)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			t.Fatalf("Failed reading history: %v", err)
+		}
+		events = append(events, event)
+	}
+
+	data, err := protojson.Marshal(&history.History{Events: events})
+	if err != nil {
+		t.Fatalf("Failed marshaling history: %v", err)
+	}
+	if err := os.WriteFile(historyFile, data, 0o644); err != nil {
+		t.Fatalf("Failed writing %s: %v", historyFile, err)
+	}
+}
+
+// TestReplaySayHelloWorkflow replays the recorded history in
+// testdata/sayhello_history.json against the current SayHelloWorkflow code
+// and fails if the replay diverges, catching non-determinism before it ever
+// reaches a running worker.
+func TestReplaySayHelloWorkflow(t *testing.T) {
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflowWithOptions(SayHelloWorkflow, workflow.RegisterOptions{Name: SayHello.Name})
+
+	logger := sdklog.NewStructuredLogger(slog.Default())
+	if err := replayer.ReplayWorkflowHistoryFromJSONFile(logger, historyFile); err != nil {
+		t.Fatalf("non-deterministic change detected replaying %s: %v", historyFile, err)
+	}
+}